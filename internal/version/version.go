@@ -0,0 +1,99 @@
+// Package version holds the build metadata injected by the release build.
+package version
+
+import (
+	"encoding/json"
+	"fmt"
+	"runtime"
+	"runtime/debug"
+)
+
+// Default values reported when the build does not provide metadata.
+const (
+	defaultCommit    = "none"
+	defaultBuildDate = "unknown"
+)
+
+// These are set at build time via -ldflags (see .goreleaser.yaml).
+var (
+	Version   = "dev"
+	Commit    = defaultCommit
+	BuildDate = defaultBuildDate
+	BuiltBy   = "source"
+)
+
+// TemplateCommit is the commit of the app templates in use. There is no
+// templates handling yet, so it is always reported as unknown.
+var TemplateCommit = "unknown"
+
+// Info is the structured form of the build metadata. The JSON keys are
+// read by external tools and must stay stable.
+type Info struct {
+	Version        string `json:"version"`
+	Commit         string `json:"commit"`
+	BuildDate      string `json:"build_date"`
+	GoVersion      string `json:"go_version"`
+	BuiltBy        string `json:"built_by"`
+	Platform       string `json:"platform"`
+	TemplateCommit string `json:"template_commit"`
+}
+
+// Get returns the build metadata for the running binary. Source builds
+// that were not stamped via -ldflags fall back to the VCS information
+// recorded by the Go toolchain.
+func Get() Info {
+	info := Info{
+		Version:        Version,
+		Commit:         Commit,
+		BuildDate:      BuildDate,
+		GoVersion:      runtime.Version(),
+		BuiltBy:        BuiltBy,
+		Platform:       runtime.GOOS + "/" + runtime.GOARCH,
+		TemplateCommit: TemplateCommit,
+	}
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		applyBuildInfo(&info, bi)
+	}
+	return info
+}
+
+// applyBuildInfo fills Commit and BuildDate from the toolchain's VCS
+// settings when they still hold their defaults.
+func applyBuildInfo(info *Info, bi *debug.BuildInfo) {
+	var revision, vcsTime string
+	modified := false
+	for _, s := range bi.Settings {
+		switch s.Key {
+		case "vcs.revision":
+			revision = s.Value
+		case "vcs.time":
+			vcsTime = s.Value
+		case "vcs.modified":
+			modified = s.Value == "true"
+		}
+	}
+	if info.Commit == defaultCommit && revision != "" {
+		info.Commit = revision
+		if modified {
+			info.Commit += "-dirty"
+		}
+	}
+	if info.BuildDate == defaultBuildDate && vcsTime != "" {
+		info.BuildDate = vcsTime
+	}
+}
+
+// String returns the human readable version line.
+func (i Info) String() string {
+	return fmt.Sprintf("ds2 %s (commit %s, built %s by %s, %s, %s)",
+		i.Version, i.Commit, i.BuildDate, i.BuiltBy, i.GoVersion, i.Platform)
+}
+
+// JSON returns the build metadata as indented JSON.
+func (i Info) JSON() (string, error) {
+	b, err := json.MarshalIndent(i, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
@@ -0,0 +1,84 @@
+package version
+
+import (
+	"encoding/json"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"testing"
+)
+
+func setVars(t *testing.T, version, commit, buildDate, builtBy string) {
+	t.Helper()
+	oldVersion, oldCommit, oldBuildDate, oldBuiltBy := Version, Commit, BuildDate, BuiltBy
+	t.Cleanup(func() {
+		Version, Commit, BuildDate, BuiltBy = oldVersion, oldCommit, oldBuildDate, oldBuiltBy
+	})
+	Version, Commit, BuildDate, BuiltBy = version, commit, buildDate, builtBy
+}
+
+func TestJSONKeys(t *testing.T) {
+	setVars(t, "v2.1.0", "abc1234", "2026-10-14T00:00:00Z", "goreleaser")
+
+	out, err := Get().JSON()
+	if err != nil {
+		t.Fatalf("JSON() returned error: %v", err)
+	}
+
+	var got map[string]string
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("JSON() output does not parse: %v\n%s", err, out)
+	}
+
+	want := map[string]string{
+		"version":         "v2.1.0",
+		"commit":          "abc1234",
+		"build_date":      "2026-10-14T00:00:00Z",
+		"go_version":      runtime.Version(),
+		"built_by":        "goreleaser",
+		"platform":        runtime.GOOS + "/" + runtime.GOARCH,
+		"template_commit": "unknown",
+	}
+	if len(got) != len(want) {
+		t.Errorf("JSON() has %d keys, want %d: %v", len(got), len(want), got)
+	}
+	for key, value := range want {
+		if got[key] != value {
+			t.Errorf("JSON()[%q] = %q, want %q", key, got[key], value)
+		}
+	}
+}
+
+func TestString(t *testing.T) {
+	setVars(t, "v2.1.0", "abc1234", "2026-10-14T00:00:00Z", "goreleaser")
+
+	s := Get().String()
+	for _, want := range []string{"v2.1.0", "abc1234"} {
+		if !strings.Contains(s, want) {
+			t.Errorf("String() = %q, missing %q", s, want)
+		}
+	}
+}
+
+func TestApplyBuildInfo(t *testing.T) {
+	bi := &debug.BuildInfo{Settings: []debug.BuildSetting{
+		{Key: "vcs.revision", Value: "deadbeef"},
+		{Key: "vcs.time", Value: "2026-10-01T12:00:00Z"},
+		{Key: "vcs.modified", Value: "true"},
+	}}
+
+	info := Info{Commit: defaultCommit, BuildDate: defaultBuildDate}
+	applyBuildInfo(&info, bi)
+	if info.Commit != "deadbeef-dirty" {
+		t.Errorf("Commit = %q, want %q", info.Commit, "deadbeef-dirty")
+	}
+	if info.BuildDate != "2026-10-01T12:00:00Z" {
+		t.Errorf("BuildDate = %q, want %q", info.BuildDate, "2026-10-01T12:00:00Z")
+	}
+
+	info = Info{Commit: "abc1234", BuildDate: "2026-10-14T00:00:00Z"}
+	applyBuildInfo(&info, bi)
+	if info.Commit != "abc1234" || info.BuildDate != "2026-10-14T00:00:00Z" {
+		t.Errorf("ldflags values were overwritten: %+v", info)
+	}
+}
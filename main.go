@@ -1,7 +1,44 @@
 package main
 
-import "fmt"
+import (
+	"fmt"
+	"os"
+
+	"DockSTARTer2/internal/version"
+)
 
 func main() {
+	// Only the version flags are handled for now; any other arguments are
+	// ignored until the full command line lands.
+	showVersion, asJSON := false, false
+	for _, arg := range os.Args[1:] {
+		switch arg {
+		case "--version", "-version":
+			showVersion = true
+		case "--json", "-json":
+			asJSON = true
+		}
+	}
+
+	if asJSON && !showVersion {
+		fmt.Fprintln(os.Stderr, "Error: --json can only be used with --version")
+		os.Exit(2)
+	}
+
+	if showVersion {
+		info := version.Get()
+		if !asJSON {
+			fmt.Println(info)
+			return
+		}
+		out, err := info.JSON()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Error:", err)
+			os.Exit(1)
+		}
+		fmt.Println(out)
+		return
+	}
+
 	fmt.Println("Hello, World!")
 }